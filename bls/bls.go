@@ -0,0 +1,178 @@
+// Package bls wraps github.com/consensys/gnark-crypto's BLS12-381
+// implementation to turn the *big.Int secret keys produced by DeriveKey
+// and Deriver into working BLS signatures. It implements the
+// minimal-pubkey-size variant (G1 public keys, G2 signatures) of
+// draft-irtf-cfrg-bls-signature-05 with the proof-of-possession scheme,
+// matching the ciphersuite used throughout the Ethereum consensus layer.
+package bls
+
+import (
+	"errors"
+	"math/big"
+
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+)
+
+// DST is the domain separation tag for message signatures under this
+// ciphersuite.
+const DST = "BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_"
+
+// PopDST is the domain separation tag used for proof-of-possession
+// signatures, which bind a secret key's holder to its public key.
+const PopDST = "BLS_POP_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_"
+
+// ErrNoSignatures is returned by AggregateSignatures when given no
+// signatures to combine.
+var ErrNoSignatures = errors.New("bls: no signatures to aggregate")
+
+// ErrNoPublicKeys is returned by FastAggregateVerify when given no public
+// keys to verify against.
+var ErrNoPublicKeys = errors.New("bls: no public keys to verify against")
+
+// SecretKey is a BLS12-381 secret key: a scalar in the scalar field of
+// the curve's r-order subgroup.
+type SecretKey struct {
+	scalar fr.Element
+}
+
+// FromDerived builds sk from the *big.Int returned by DeriveKey or
+// Deriver, and returns sk for chaining.
+func (sk *SecretKey) FromDerived(derived *big.Int) *SecretKey {
+	sk.scalar.SetBigInt(derived)
+	return sk
+}
+
+// PublicKey returns the public key g1^sk corresponding to sk.
+func (sk *SecretKey) PublicKey() *PublicKey {
+	_, _, g1, _ := bls12381.Generators()
+
+	var scalar big.Int
+	sk.scalar.BigInt(&scalar)
+
+	var point bls12381.G1Affine
+	point.ScalarMultiplication(&g1, &scalar)
+
+	return &PublicKey{point: point}
+}
+
+// Sign signs msg under the domain separation tag dst, producing a G2
+// signature.
+func (sk *SecretKey) Sign(msg, dst []byte) (*Signature, error) {
+	hashed, err := bls12381.HashToG2(msg, dst)
+	if err != nil {
+		return nil, err
+	}
+
+	var scalar big.Int
+	sk.scalar.BigInt(&scalar)
+
+	var point bls12381.G2Affine
+	point.ScalarMultiplication(&hashed, &scalar)
+
+	return &Signature{point: point}, nil
+}
+
+// ProveKnowledge produces a proof of possession for sk: a signature,
+// under PopDST, over sk's own public key. This lets verifiers reject
+// rogue public-key attacks during aggregation.
+func (sk *SecretKey) ProveKnowledge() (*Signature, error) {
+	return sk.Sign(sk.PublicKey().Bytes(), []byte(PopDST))
+}
+
+// PublicKey is a BLS12-381 public key: a point in G1.
+type PublicKey struct {
+	point bls12381.G1Affine
+}
+
+// Bytes returns the 48 byte compressed encoding of pk.
+func (pk *PublicKey) Bytes() []byte {
+	b := pk.point.Bytes()
+	return b[:]
+}
+
+// Verify checks that sig is a valid signature over msg (under dst) by
+// the secret key behind pk.
+func (pk *PublicKey) Verify(sig *Signature, msg, dst []byte) (bool, error) {
+	hashed, err := bls12381.HashToG2(msg, dst)
+	if err != nil {
+		return false, err
+	}
+
+	_, _, g1, _ := bls12381.Generators()
+
+	var negG1 bls12381.G1Affine
+	negG1.Neg(&g1)
+
+	return bls12381.PairingCheck(
+		[]bls12381.G1Affine{negG1, pk.point},
+		[]bls12381.G2Affine{sig.point, hashed},
+	)
+}
+
+// VerifyKnowledge checks a proof of possession produced by
+// SecretKey.ProveKnowledge.
+func (pk *PublicKey) VerifyKnowledge(pop *Signature) (bool, error) {
+	return pk.Verify(pop, pk.Bytes(), []byte(PopDST))
+}
+
+// aggregatePublicKeys sums a set of G1 public keys.
+func aggregatePublicKeys(pubKeys []*PublicKey) bls12381.G1Affine {
+	var aggregate bls12381.G1Jac
+	aggregate.FromAffine(&pubKeys[0].point)
+
+	for _, pubKey := range pubKeys[1:] {
+		var point bls12381.G1Jac
+		point.FromAffine(&pubKey.point)
+		aggregate.AddAssign(&point)
+	}
+
+	var result bls12381.G1Affine
+	result.FromJacobian(&aggregate)
+
+	return result
+}
+
+// Signature is a BLS12-381 signature: a point in G2.
+type Signature struct {
+	point bls12381.G2Affine
+}
+
+// Bytes returns the 96 byte compressed encoding of sig.
+func (sig *Signature) Bytes() []byte {
+	b := sig.point.Bytes()
+	return b[:]
+}
+
+// AggregateSignatures combines signatures into a single aggregate
+// signature usable with FastAggregateVerify.
+func AggregateSignatures(signatures ...*Signature) (*Signature, error) {
+	if len(signatures) == 0 {
+		return nil, ErrNoSignatures
+	}
+
+	var aggregate bls12381.G2Jac
+	aggregate.FromAffine(&signatures[0].point)
+
+	for _, sig := range signatures[1:] {
+		var point bls12381.G2Jac
+		point.FromAffine(&sig.point)
+		aggregate.AddAssign(&point)
+	}
+
+	var result bls12381.G2Affine
+	result.FromJacobian(&aggregate)
+
+	return &Signature{point: result}, nil
+}
+
+// FastAggregateVerify checks that aggregate is the aggregation of each
+// public key in pubKeys independently signing the same msg under dst.
+func FastAggregateVerify(pubKeys []*PublicKey, msg, dst []byte, aggregate *Signature) (bool, error) {
+	if len(pubKeys) == 0 {
+		return false, ErrNoPublicKeys
+	}
+
+	aggregatePK := PublicKey{point: aggregatePublicKeys(pubKeys)}
+	return aggregatePK.Verify(aggregate, msg, dst)
+}
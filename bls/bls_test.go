@@ -0,0 +1,152 @@
+package bls
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	gobls12381 "github.com/VIWET/GoBLS12381"
+)
+
+// Test_PublicKey_knownVectors checks PublicKey derivation (sk -> g1^sk)
+// against fixed values computed independently of this package, straight
+// from the standard BLS12-381 G1 generator coordinates and the curve
+// equation y^2 = x^3 + 4 over the base field, then serialized per the
+// IETF/consensus-spec compressed point encoding. A self-referential
+// sign/verify round trip can't catch a wrong generator point or a wrong
+// compression routine; these vectors can.
+func Test_PublicKey_knownVectors(t *testing.T) {
+	tests := []struct {
+		sk int64
+		pk string
+	}{
+		{
+			// sk = 1: pk is the G1 generator itself.
+			sk: 1,
+			pk: "97f1d3a73197d7942695638c4fa9ac0fc3688c4f9774b905a14e3a3f171bac586c55e83ff97a1aeffb3af00adb22c6bb",
+		},
+		{
+			// sk = 2: pk is the doubled G1 generator.
+			sk: 2,
+			pk: "a572cbea904d67468808c8eb50a9450c9721db309128012543902d0ac358a62ae28f75bb8f1c7c42c39a8c5529bf0f4e",
+		},
+	}
+
+	for _, test := range tests {
+		var sk SecretKey
+		sk.FromDerived(big.NewInt(test.sk))
+
+		got := hex.EncodeToString(sk.PublicKey().Bytes())
+		if got != test.pk {
+			t.Fatalf("Invalid public key for sk=%d:\nWant: %s\nGot:  %s\n", test.sk, test.pk, got)
+		}
+	}
+}
+
+func Test_SignVerify_roundTrip(t *testing.T) {
+	seed := make([]byte, 32)
+	for i := range seed {
+		seed[i] = byte(i + 1)
+	}
+
+	derived, err := gobls12381.DeriveKey(seed, gobls12381.SigningKeyPath(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var sk SecretKey
+	sk.FromDerived(derived)
+
+	msg := []byte("attestation payload")
+
+	sig, err := sk.Sign(msg, []byte(DST))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pk := sk.PublicKey()
+
+	ok, err := pk.Verify(sig, msg, []byte(DST))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ok {
+		t.Fatal("expected signature to verify")
+	}
+
+	if ok, err := pk.Verify(sig, []byte("different payload"), []byte(DST)); err != nil {
+		t.Fatal(err)
+	} else if ok {
+		t.Fatal("expected signature over a different message to fail verification")
+	}
+}
+
+func Test_ProveVerifyKnowledge(t *testing.T) {
+	derived, err := gobls12381.DeriveKey(make([]byte, 32), gobls12381.WithdrawalKeyPath(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var sk SecretKey
+	sk.FromDerived(derived)
+
+	pop, err := sk.ProveKnowledge()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ok, err := sk.PublicKey().VerifyKnowledge(pop)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ok {
+		t.Fatal("expected proof of possession to verify")
+	}
+}
+
+func Test_AggregateSignatures_fastAggregateVerify(t *testing.T) {
+	msg := []byte("shared message")
+
+	var (
+		pubKeys    []*PublicKey
+		signatures []*Signature
+	)
+	for i := uint32(0); i < 4; i++ {
+		derived, err := gobls12381.DeriveKey(make([]byte, 32), gobls12381.SigningKeyPath(i))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var sk SecretKey
+		sk.FromDerived(derived)
+
+		sig, err := sk.Sign(msg, []byte(DST))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		pubKeys = append(pubKeys, sk.PublicKey())
+		signatures = append(signatures, sig)
+	}
+
+	aggregate, err := AggregateSignatures(signatures...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ok, err := FastAggregateVerify(pubKeys, msg, []byte(DST), aggregate)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ok {
+		t.Fatal("expected aggregate signature to verify")
+	}
+
+	if _, err := AggregateSignatures(); err != ErrNoSignatures {
+		t.Fatalf("expected ErrNoSignatures, got: %v", err)
+	}
+
+	if _, err := FastAggregateVerify(nil, msg, []byte(DST), aggregate); err != ErrNoPublicKeys {
+		t.Fatalf("expected ErrNoPublicKeys, got: %v", err)
+	}
+}
@@ -0,0 +1,65 @@
+package gobls12381
+
+import "math/big"
+
+// Deriver caches a seed's master SK so that many sibling keys can be
+// derived from the same seed without recomputing master derivation on
+// every call, unlike DeriveKey.
+type Deriver struct {
+	masterKey *big.Int
+}
+
+// NewDeriver computes and caches the master SK for seed.
+func NewDeriver(seed []byte) (*Deriver, error) {
+	masterKey, err := deriveMasterSecretKey(seed)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Deriver{masterKey: masterKey}, nil
+}
+
+// Derive returns the secret key at path, relative to the cached master SK.
+func (d *Deriver) Derive(path string) (*big.Int, error) {
+	return d.DeriveWithKeyInfo(path, nil)
+}
+
+// DeriveChild returns the secret key of the master SK's direct child at
+// index.
+func (d *Deriver) DeriveChild(index uint32) (*big.Int, error) {
+	return deriveChildSecretKey(d.masterKey, index)
+}
+
+// DeriveWithKeyInfo returns the secret key at path, additionally mixing
+// keyInfo into the final node's hkdf_mod_r call as allowed by the IETF
+// hkdf_mod_r spec, enabling domain-separated derivations (e.g. deriving
+// distinct keys for distinct purposes from what would otherwise be the
+// same node).
+func (d *Deriver) DeriveWithKeyInfo(path string, keyInfo []byte) (*big.Int, error) {
+	indices, err := parsePath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	// Copy the cached master key before any use: with an empty path the
+	// loop below never runs and key would otherwise alias d.masterKey,
+	// letting a caller's in-place big.Int mutation corrupt the Deriver.
+	key := new(big.Int).Set(d.masterKey)
+	for i, index := range indices {
+		lamportPublicKey, err := deriveLamportPublicKeyFromParentKey(key, index)
+		if err != nil {
+			return nil, err
+		}
+
+		if i == len(indices)-1 && len(keyInfo) > 0 {
+			key, err = deriveHKDFModR(lamportPublicKey, keyInfo...)
+		} else {
+			key, err = deriveHKDFModR(lamportPublicKey)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return key, nil
+}
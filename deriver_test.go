@@ -0,0 +1,126 @@
+package gobls12381
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"testing"
+)
+
+func Test_Deriver_Derive(t *testing.T) {
+	f := func(t *testing.T, seedHex, path, wantedKey string) {
+		seed, err := hex.DecodeString(seedHex)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		deriver, err := NewDeriver(seed)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		key, err := deriver.Derive(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if key.String() != wantedKey {
+			t.Fatalf(
+				"Invalid key:\nWant: %s\nGot:  %s\n",
+				wantedKey,
+				key.String(),
+			)
+		}
+	}
+	var tests []struct {
+		Seed string `json:"seed"`
+		Path string `json:"path"`
+		Key  string `json:"key"`
+	}
+	file, err := os.Open("tests/derive_key.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	if err := json.NewDecoder(file).Decode(&tests); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, test := range tests {
+		f(t, test.Seed, test.Path, test.Key)
+	}
+}
+
+// Test_Deriver_Derive_masterKeyNotAliased ensures Derive("m") hands back a
+// copy of the cached master key, not the master key itself, so that a
+// caller mutating the returned *big.Int in place can't corrupt the
+// Deriver's cache for later calls.
+func Test_Deriver_Derive_masterKeyNotAliased(t *testing.T) {
+	seed := make([]byte, 32)
+	for i := range seed {
+		seed[i] = byte(i)
+	}
+
+	deriver, err := NewDeriver(seed)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	before, err := deriver.Derive("m/12381/3600/0/0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	root, err := deriver.Derive("m")
+	if err != nil {
+		t.Fatal(err)
+	}
+	root.SetInt64(0)
+
+	after, err := deriver.Derive("m/12381/3600/0/0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if before.Cmp(after) != 0 {
+		t.Fatalf("mutating Derive(\"m\")'s result corrupted the cached master key:\nBefore: %s\nAfter:  %s\n", before.String(), after.String())
+	}
+}
+
+func Test_Deriver_DeriveWithKeyInfo_domainSeparation(t *testing.T) {
+	seed := make([]byte, 32)
+	for i := range seed {
+		seed[i] = byte(i)
+	}
+
+	deriver, err := NewDeriver(seed)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	path := "m/12381/3600/0/0"
+
+	plain, err := deriver.Derive(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	withInfo, err := deriver.DeriveWithKeyInfo(path, []byte("domain-a"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if plain.Cmp(withInfo) == 0 {
+		t.Fatal("expected key_info to change the derived key")
+	}
+
+	withSameInfo, err := deriver.DeriveWithKeyInfo(path, []byte("domain-a"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if withInfo.Cmp(withSameInfo) != 0 {
+		t.Fatal("expected the same key_info to derive the same key")
+	}
+}
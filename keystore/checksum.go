@@ -0,0 +1,53 @@
+package keystore
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+)
+
+// checksumSHA256 is the only crypto.checksum.function this package
+// supports.
+const checksumSHA256 = "sha256"
+
+// checksumModule is the decoded form of crypto.checksum: the SHA-256
+// digest of dk[16:32] || cipher ciphertext, used to detect a wrong
+// password without ever decrypting.
+type checksumModule struct {
+	Function string
+	Message  []byte
+}
+
+type checksumModuleJSON struct {
+	Function string                 `json:"function"`
+	Params   map[string]interface{} `json:"params"`
+	Message  string                 `json:"message"`
+}
+
+func (m checksumModule) MarshalJSON() ([]byte, error) {
+	return json.Marshal(checksumModuleJSON{
+		Function: m.Function,
+		Params:   map[string]interface{}{},
+		Message:  hex.EncodeToString(m.Message),
+	})
+}
+
+func (m *checksumModule) UnmarshalJSON(data []byte) error {
+	var raw checksumModuleJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	if raw.Function != checksumSHA256 {
+		return fmt.Errorf("keystore: unsupported checksum function %q", raw.Function)
+	}
+
+	message, err := hex.DecodeString(raw.Message)
+	if err != nil {
+		return fmt.Errorf("failed to decode checksum message: %w", err)
+	}
+
+	m.Function = raw.Function
+	m.Message = message
+	return nil
+}
@@ -0,0 +1,77 @@
+package keystore
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+)
+
+// cipherAES128CTR is the only crypto.cipher.function this package supports.
+const cipherAES128CTR = "aes-128-ctr"
+
+// cipherModule is the decoded form of crypto.cipher: the cipher used to
+// protect the secret key, its IV and the resulting ciphertext.
+type cipherModule struct {
+	Function string
+	IV       []byte
+	Message  []byte
+}
+
+type cipherModuleJSON struct {
+	Function string `json:"function"`
+	Params   struct {
+		IV string `json:"iv"`
+	} `json:"params"`
+	Message string `json:"message"`
+}
+
+func (m cipherModule) MarshalJSON() ([]byte, error) {
+	var raw cipherModuleJSON
+	raw.Function = m.Function
+	raw.Params.IV = hex.EncodeToString(m.IV)
+	raw.Message = hex.EncodeToString(m.Message)
+
+	return json.Marshal(raw)
+}
+
+func (m *cipherModule) UnmarshalJSON(data []byte) error {
+	var raw cipherModuleJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	if raw.Function != cipherAES128CTR {
+		return fmt.Errorf("keystore: unsupported cipher function %q", raw.Function)
+	}
+
+	iv, err := hex.DecodeString(raw.Params.IV)
+	if err != nil {
+		return fmt.Errorf("failed to decode cipher iv: %w", err)
+	}
+
+	message, err := hex.DecodeString(raw.Message)
+	if err != nil {
+		return fmt.Errorf("failed to decode cipher message: %w", err)
+	}
+
+	m.Function = raw.Function
+	m.IV = iv
+	m.Message = message
+	return nil
+}
+
+// aes128CTR runs AES-128-CTR over data; being a stream cipher it is its
+// own inverse, so the same call both encrypts and decrypts.
+func aes128CTR(key, iv, data []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]byte, len(data))
+	cipher.NewCTR(block, iv).XORKeyStream(out, data)
+
+	return out, nil
+}
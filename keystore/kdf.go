@@ -0,0 +1,188 @@
+package keystore
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/crypto/scrypt"
+)
+
+// KDF names the key derivation function used to stretch a password into
+// the decryption key, per the EIP-2335 crypto.kdf.function field.
+type KDF string
+
+const (
+	// KDFScrypt selects scrypt, the EIP-2335 reference implementation's
+	// default.
+	KDFScrypt KDF = "scrypt"
+	// KDFPbkdf2 selects PBKDF2-HMAC-SHA256.
+	KDFPbkdf2 KDF = "pbkdf2"
+
+	// dkLen is the length, in bytes, of the derived key. Its first 16
+	// bytes are the AES-128-CTR key, the next 16 feed the checksum.
+	dkLen = 32
+
+	pbkdf2PRF = "hmac-sha256"
+)
+
+// ErrInvalidDKLen is returned when a keystore's crypto.kdf.params.dklen
+// isn't 32, the only length EIP-2335 permits: the derived key's first 16
+// bytes become the AES-128-CTR key and the next 16 feed the checksum, so
+// a shorter or zero dklen would otherwise be forwarded straight into the
+// KDF and crash or silently truncate that split.
+var ErrInvalidDKLen = errors.New("keystore: dklen must be 32")
+
+// ScryptParams holds the scrypt cost parameters used by Options when
+// selecting KDFScrypt.
+type ScryptParams struct {
+	N, R, P int
+}
+
+// DefaultScryptParams mirrors the parameters used by the EIP-2335
+// reference implementation and the Ethereum staking deposit-cli.
+var DefaultScryptParams = ScryptParams{N: 262144, R: 8, P: 1}
+
+// Pbkdf2Params holds the PBKDF2 iteration count used by Options when
+// selecting KDFPbkdf2.
+type Pbkdf2Params struct {
+	Iterations int
+}
+
+// DefaultPbkdf2Params mirrors the EIP-2335 reference implementation.
+var DefaultPbkdf2Params = Pbkdf2Params{Iterations: 262144}
+
+// kdfParams is implemented by scryptParams and pbkdf2Params, the two
+// on-disk representations of crypto.kdf.params.
+type kdfParams interface {
+	deriveKey(password string) ([]byte, error)
+}
+
+type scryptParams struct {
+	DKLen int    `json:"dklen"`
+	N     int    `json:"n"`
+	P     int    `json:"p"`
+	R     int    `json:"r"`
+	Salt  string `json:"salt"`
+}
+
+func (p scryptParams) deriveKey(password string) ([]byte, error) {
+	if p.DKLen != dkLen {
+		return nil, ErrInvalidDKLen
+	}
+
+	salt, err := hex.DecodeString(p.Salt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode scrypt salt: %w", err)
+	}
+
+	return scrypt.Key([]byte(password), salt, p.N, p.R, p.P, p.DKLen)
+}
+
+type pbkdf2Params struct {
+	DKLen int    `json:"dklen"`
+	C     int    `json:"c"`
+	PRF   string `json:"prf"`
+	Salt  string `json:"salt"`
+}
+
+func (p pbkdf2Params) deriveKey(password string) ([]byte, error) {
+	if p.DKLen != dkLen {
+		return nil, ErrInvalidDKLen
+	}
+
+	if p.PRF != pbkdf2PRF {
+		return nil, fmt.Errorf("keystore: unsupported pbkdf2 prf %q", p.PRF)
+	}
+
+	salt, err := hex.DecodeString(p.Salt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode pbkdf2 salt: %w", err)
+	}
+
+	return pbkdf2.Key([]byte(password), salt, p.C, p.DKLen, sha256.New), nil
+}
+
+// kdfModule is the decoded form of crypto.kdf. Its params shape depends on
+// function, so it carries its own JSON (un)marshalling.
+type kdfModule struct {
+	Function KDF
+	Params   kdfParams
+}
+
+type kdfModuleJSON struct {
+	Function KDF             `json:"function"`
+	Params   json.RawMessage `json:"params"`
+	Message  string          `json:"message"`
+}
+
+func (m kdfModule) MarshalJSON() ([]byte, error) {
+	params, err := json.Marshal(m.Params)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(kdfModuleJSON{Function: m.Function, Params: params})
+}
+
+func (m *kdfModule) UnmarshalJSON(data []byte) error {
+	var raw kdfModuleJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	switch raw.Function {
+	case KDFScrypt:
+		var params scryptParams
+		if err := json.Unmarshal(raw.Params, &params); err != nil {
+			return err
+		}
+
+		m.Params = params
+	case KDFPbkdf2:
+		var params pbkdf2Params
+		if err := json.Unmarshal(raw.Params, &params); err != nil {
+			return err
+		}
+
+		m.Params = params
+	default:
+		return fmt.Errorf("keystore: unsupported kdf function %q", raw.Function)
+	}
+
+	m.Function = raw.Function
+	return nil
+}
+
+// newKDFModule builds the kdf module for opts, generating fresh params
+// seeded with salt.
+func newKDFModule(opts *Options, salt []byte) (kdfModule, error) {
+	switch opts.KDF {
+	case "", KDFScrypt:
+		return kdfModule{
+			Function: KDFScrypt,
+			Params: scryptParams{
+				DKLen: dkLen,
+				N:     opts.Scrypt.N,
+				P:     opts.Scrypt.P,
+				R:     opts.Scrypt.R,
+				Salt:  hex.EncodeToString(salt),
+			},
+		}, nil
+	case KDFPbkdf2:
+		return kdfModule{
+			Function: KDFPbkdf2,
+			Params: pbkdf2Params{
+				DKLen: dkLen,
+				C:     opts.Pbkdf2.Iterations,
+				PRF:   pbkdf2PRF,
+				Salt:  hex.EncodeToString(salt),
+			},
+		}, nil
+	default:
+		return kdfModule{}, fmt.Errorf("keystore: unsupported kdf %q", opts.KDF)
+	}
+}
@@ -0,0 +1,177 @@
+// Package keystore implements the EIP-2335 BLS12-381 keystore format: a
+// JSON envelope that protects an EIP-2333-derived secret key at rest
+// behind a password-based KDF (scrypt or PBKDF2) and AES-128-CTR
+// encryption, giving callers an end-to-end story from seed to
+// derived SK to on-disk key file.
+package keystore
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+const (
+	// Version is the only EIP-2335 keystore format version this package
+	// produces and accepts.
+	Version = 4
+
+	skLength   = 32
+	ivLength   = 16
+	saltLength = 32
+)
+
+// ErrInvalidPassword is returned by Decrypt when the checksum does not
+// match, meaning the password (or the keystore) is wrong.
+var ErrInvalidPassword = errors.New("keystore: invalid password")
+
+// ErrUnsupportedVersion is returned by Decrypt for a keystore whose
+// version isn't Version. Decoding the JSON does not check the version.
+var ErrUnsupportedVersion = errors.New("keystore: unsupported version")
+
+// Options configures Encrypt: which KDF to use and the path/pubkey/
+// description metadata stored alongside the ciphertext.
+type Options struct {
+	// KDF selects the key derivation function. The zero value is
+	// KDFScrypt.
+	KDF    KDF
+	Scrypt ScryptParams
+	Pbkdf2 Pbkdf2Params
+
+	// Path is the EIP-2334 derivation path the secret key came from, if
+	// any (see ValidatePath in the parent package).
+	Path string
+	// PublicKey is the BLS public key corresponding to sk, stored
+	// alongside it for identification. Optional.
+	PublicKey []byte
+	// Description is a free-form, human readable label.
+	Description string
+}
+
+// DefaultOptions returns Options configured for scrypt with the
+// parameters used by the EIP-2335 reference implementation.
+func DefaultOptions() *Options {
+	return &Options{
+		KDF:    KDFScrypt,
+		Scrypt: DefaultScryptParams,
+		Pbkdf2: DefaultPbkdf2Params,
+	}
+}
+
+// Keystore is the decoded form of an EIP-2335 keystore JSON file.
+type Keystore struct {
+	Crypto      crypto `json:"crypto"`
+	Description string `json:"description"`
+	PubKey      string `json:"pubkey"`
+	Path        string `json:"path"`
+	UUID        string `json:"uuid"`
+	Version     int    `json:"version"`
+}
+
+// crypto is the decoded form of the keystore's "crypto" object.
+type crypto struct {
+	KDF      kdfModule      `json:"kdf"`
+	Checksum checksumModule `json:"checksum"`
+	Cipher   cipherModule   `json:"cipher"`
+}
+
+// Encrypt encrypts sk into an EIP-2335 keystore under password. A nil
+// opts is equivalent to DefaultOptions().
+func Encrypt(sk *big.Int, password string, opts *Options) (*Keystore, error) {
+	if opts == nil {
+		opts = DefaultOptions()
+	}
+
+	password, err := normalizePassword(password)
+	if err != nil {
+		return nil, fmt.Errorf("failed to normalize password: %w", err)
+	}
+
+	salt := make([]byte, saltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, fmt.Errorf("failed to read kdf salt: %w", err)
+	}
+
+	kdf, err := newKDFModule(opts, salt)
+	if err != nil {
+		return nil, err
+	}
+
+	dk, err := kdf.Params.deriveKey(password)
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive key: %w", err)
+	}
+
+	iv := make([]byte, ivLength)
+	if _, err := rand.Read(iv); err != nil {
+		return nil, fmt.Errorf("failed to read cipher iv: %w", err)
+	}
+
+	plaintext := make([]byte, skLength)
+	sk.FillBytes(plaintext)
+
+	ciphertext, err := aes128CTR(dk[:16], iv, plaintext)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt secret key: %w", err)
+	}
+
+	id, err := newUUID()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Keystore{
+		Crypto: crypto{
+			KDF:      kdf,
+			Checksum: checksumModule{Function: checksumSHA256, Message: checksumMessage(dk, ciphertext)},
+			Cipher:   cipherModule{Function: cipherAES128CTR, IV: iv, Message: ciphertext},
+		},
+		Description: opts.Description,
+		PubKey:      hex.EncodeToString(opts.PublicKey),
+		Path:        opts.Path,
+		UUID:        id,
+		Version:     Version,
+	}, nil
+}
+
+// Decrypt recovers the secret key protected by k under password.
+func (k *Keystore) Decrypt(password string) (*big.Int, error) {
+	if k.Version != Version {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedVersion, k.Version)
+	}
+
+	password, err := normalizePassword(password)
+	if err != nil {
+		return nil, fmt.Errorf("failed to normalize password: %w", err)
+	}
+
+	dk, err := k.Crypto.KDF.Params.deriveKey(password)
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive key: %w", err)
+	}
+
+	if !bytes.Equal(checksumMessage(dk, k.Crypto.Cipher.Message), k.Crypto.Checksum.Message) {
+		return nil, ErrInvalidPassword
+	}
+
+	plaintext, err := aes128CTR(dk[:16], k.Crypto.Cipher.IV, k.Crypto.Cipher.Message)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt secret key: %w", err)
+	}
+
+	return new(big.Int).SetBytes(plaintext), nil
+}
+
+// checksumMessage computes the SHA-256 of dk[16:32] || ciphertext, the
+// value stored in crypto.checksum.message.
+func checksumMessage(dk, ciphertext []byte) []byte {
+	h := sha256.New()
+	h.Write(dk[16:32])
+	h.Write(ciphertext)
+
+	return h.Sum(nil)
+}
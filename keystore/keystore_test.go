@@ -0,0 +1,102 @@
+package keystore
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+)
+
+func Test_EncryptDecrypt_roundTrip(t *testing.T) {
+	sk := big.NewInt(0).SetBytes([]byte("some not-so-random secret key!!"))
+
+	tests := []struct {
+		name string
+		opts *Options
+	}{
+		{
+			name: "scrypt",
+			opts: &Options{
+				KDF:         KDFScrypt,
+				Scrypt:      ScryptParams{N: 1024, R: 8, P: 1},
+				Path:        "m/12381/3600/0/0",
+				Description: "test key",
+			},
+		},
+		{
+			name: "pbkdf2",
+			opts: &Options{
+				KDF:    KDFPbkdf2,
+				Pbkdf2: Pbkdf2Params{Iterations: 1024},
+				Path:   "m/12381/3600/0/0",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ks, err := Encrypt(sk, "correct horse battery staple", test.opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if ks.Version != Version {
+				t.Fatalf("unexpected version: %d", ks.Version)
+			}
+
+			data, err := json.Marshal(ks)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var decoded Keystore
+			if err := json.Unmarshal(data, &decoded); err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := decoded.Decrypt("correct horse battery staple")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got.Cmp(sk) != 0 {
+				t.Fatalf("Invalid secret key:\nWant: %s\nGot:  %s\n", sk.String(), got.String())
+			}
+
+			if _, err := decoded.Decrypt("wrong password"); err != ErrInvalidPassword {
+				t.Fatalf("expected ErrInvalidPassword, got: %v", err)
+			}
+		})
+	}
+}
+
+// Test_Decrypt_invalidDKLen ensures a malformed or malicious keystore
+// can't forward an attacker-controlled dklen into the KDF and crash the
+// caller.
+func Test_Decrypt_invalidDKLen(t *testing.T) {
+	const malformed = `{
+		"crypto": {
+			"kdf": {
+				"function": "scrypt",
+				"params": {"dklen": 0, "n": 1024, "p": 1, "r": 8, "salt": "aa"},
+				"message": ""
+			},
+			"checksum": {"function": "sha256", "params": {}, "message": "aa"},
+			"cipher": {"function": "aes-128-ctr", "params": {"iv": "aabbccddeeff00112233445566778899"}, "message": "aa"}
+		},
+		"description": "",
+		"pubkey": "",
+		"path": "m/12381/3600/0/0",
+		"uuid": "00000000-0000-4000-8000-000000000000",
+		"version": 4
+	}`
+
+	var ks Keystore
+	if err := json.Unmarshal([]byte(malformed), &ks); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := ks.Decrypt("password"); !errors.Is(err, ErrInvalidDKLen) {
+		t.Fatalf("expected ErrInvalidDKLen, got: %v", err)
+	}
+}
@@ -0,0 +1,19 @@
+package keystore
+
+import (
+	"strings"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// normalizePassword converts password to its NFKD form and strips C0
+// (0x00-0x1F), DEL (0x7F) and C1 (0x80-0x9F) control code points, as
+// required by EIP-2335 before a password is fed to the KDF.
+func normalizePassword(password string) (string, error) {
+	return strings.Map(func(r rune) rune {
+		if r <= 0x1f || (r >= 0x7f && r <= 0x9f) {
+			return -1
+		}
+		return r
+	}, norm.NFKD.String(password)), nil
+}
@@ -0,0 +1,20 @@
+package keystore
+
+import (
+	"crypto/rand"
+	"fmt"
+)
+
+// newUUID generates a random (version 4, variant 1) UUID for the EIP-2335
+// "uuid" field.
+func newUUID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to read random uuid bytes: %w", err)
+	}
+
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16]), nil
+}
@@ -0,0 +1,64 @@
+package mnemonic
+
+// bitReader reads fixed-width big-endian bit groups out of a byte slice,
+// most significant bit first.
+type bitReader struct {
+	data []byte
+	pos  int
+}
+
+func newBitReader(data []byte) *bitReader {
+	return &bitReader{data: data}
+}
+
+// append makes the bits of data available to read, following whatever has
+// already been appended.
+func (r *bitReader) append(data []byte) {
+	r.data = append(r.data, data...)
+}
+
+// read consumes and returns the next n bits (n <= 32) as an integer.
+func (r *bitReader) read(n int) uint32 {
+	var value uint32
+	for i := 0; i < n; i++ {
+		byteIndex := r.pos / 8
+		bitIndex := uint(7 - r.pos%8)
+
+		bit := (r.data[byteIndex] >> bitIndex) & 1
+		value = value<<1 | uint32(bit)
+
+		r.pos++
+	}
+
+	return value
+}
+
+// bitWriter accumulates fixed-width big-endian bit groups into a byte
+// slice, most significant bit first.
+type bitWriter struct {
+	data []byte
+	pos  int
+}
+
+// newBitWriter allocates a bitWriter with enough room for totalBits bits.
+func newBitWriter(totalBits int) *bitWriter {
+	return &bitWriter{data: make([]byte, (totalBits+7)/8)}
+}
+
+// append writes the low n bits of value.
+func (w *bitWriter) append(value uint32, n int) {
+	for i := n - 1; i >= 0; i-- {
+		bit := byte(value>>uint(i)) & 1
+
+		byteIndex := w.pos / 8
+		bitIndex := uint(7 - w.pos%8)
+
+		w.data[byteIndex] |= bit << bitIndex
+		w.pos++
+	}
+}
+
+// bytes returns the accumulated bytes.
+func (w *bitWriter) bytes() []byte {
+	return w.data
+}
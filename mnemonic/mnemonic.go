@@ -0,0 +1,155 @@
+// Package mnemonic implements BIP-39 mnemonic sentences and turns them into
+// the seed consumed by the EIP-2333 key derivation tree (see DeriveKey in
+// the parent package). It mirrors how BIP-39 feeds BIP-32 elsewhere: a
+// mnemonic is generated from entropy, validated by its checksum, and
+// stretched into a seed with PBKDF2.
+package mnemonic
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/sha512"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	// pbkdf2Iterations is the number of PBKDF2-HMAC-SHA512 rounds used to
+	// stretch a mnemonic (plus passphrase) into a seed.
+	pbkdf2Iterations = 2048
+	// seedLength is the size, in bytes, of the derived seed.
+	seedLength = 64
+	// saltPrefix is prepended to the passphrase to form the PBKDF2 salt.
+	saltPrefix = "mnemonic"
+
+	// entropyBitsPerWord is the number of bits of entropy each mnemonic
+	// word encodes.
+	entropyBitsPerWord = 11
+	// minEntropyBits and maxEntropyBits bound the supported entropy sizes,
+	// matching the BIP-39 12..24 word range.
+	minEntropyBits = 128
+	maxEntropyBits = 256
+)
+
+var (
+	// ErrInvalidEntropyLength
+	ErrInvalidEntropyLength = errors.New("entropy length must be a multiple of 4 bytes between 16 and 32")
+	// ErrInvalidMnemonic
+	ErrInvalidMnemonic = errors.New("invalid mnemonic")
+	// ErrInvalidChecksum
+	ErrInvalidChecksum = errors.New("invalid mnemonic checksum")
+)
+
+// MnemonicToSeed turns a BIP-39 mnemonic phrase and an optional passphrase
+// into the 64 byte seed accepted by DeriveKey. The mnemonic is not required
+// to be valid: per BIP-39, seed derivation never checks the checksum, so
+// callers that need that guarantee should call ValidateMnemonic first.
+func MnemonicToSeed(mnemonic, passphrase string) ([]byte, error) {
+	normalizedMnemonic, err := normalize(mnemonic)
+	if err != nil {
+		return nil, fmt.Errorf("failed to normalize mnemonic: %w", err)
+	}
+
+	normalizedPassphrase, err := normalize(passphrase)
+	if err != nil {
+		return nil, fmt.Errorf("failed to normalize passphrase: %w", err)
+	}
+
+	salt := saltPrefix + normalizedPassphrase
+	seed := pbkdf2.Key([]byte(normalizedMnemonic), []byte(salt), pbkdf2Iterations, seedLength, sha512.New)
+
+	return seed, nil
+}
+
+// NewMnemonic generates a BIP-39 mnemonic sentence from the given entropy.
+// Entropy must be 16, 20, 24, 28 or 32 bytes long, yielding a 12, 15, 18, 21
+// or 24 word mnemonic respectively.
+func NewMnemonic(entropy []byte) (string, error) {
+	entropyBits := len(entropy) * 8
+	if entropyBits < minEntropyBits || entropyBits > maxEntropyBits || entropyBits%32 != 0 {
+		return "", ErrInvalidEntropyLength
+	}
+
+	checksumBits := entropyBits / 32
+	checksum := sha256.Sum256(entropy)
+
+	bits := newBitReader(entropy)
+	bits.append(checksum[:])
+
+	wordCount := (entropyBits + checksumBits) / entropyBitsPerWord
+	words := make([]string, wordCount)
+	for i := 0; i < wordCount; i++ {
+		index := bits.read(entropyBitsPerWord)
+		words[i] = englishWordlist[index]
+	}
+
+	return strings.Join(words, " "), nil
+}
+
+// NewEntropy returns cryptographically secure entropy of the given length,
+// a convenience wrapper around crypto/rand for use with NewMnemonic.
+func NewEntropy(length int) ([]byte, error) {
+	entropy := make([]byte, length)
+	if _, err := rand.Read(entropy); err != nil {
+		return nil, fmt.Errorf("failed to read random entropy: %w", err)
+	}
+
+	return entropy, nil
+}
+
+// ValidateMnemonic checks that a mnemonic sentence is composed of words from
+// the English wordlist and that its checksum (SHA-256 of the entropy,
+// truncated to ENT/32 bits) matches.
+func ValidateMnemonic(mnemonic string) error {
+	words := strings.Fields(mnemonic)
+	wordCount := len(words)
+	if wordCount < 12 || wordCount > 24 || wordCount%3 != 0 {
+		return ErrInvalidMnemonic
+	}
+
+	totalBits := wordCount * entropyBitsPerWord
+	checksumBits := totalBits / 33
+	entropyBits := totalBits - checksumBits
+
+	bits := newBitWriter(totalBits)
+	for _, word := range words {
+		index, ok := wordIndex(word)
+		if !ok {
+			return fmt.Errorf("%w: unknown word %q", ErrInvalidMnemonic, word)
+		}
+
+		bits.append(uint32(index), entropyBitsPerWord)
+	}
+
+	entropy := bits.bytes()[:entropyBits/8]
+	checksum := sha256.Sum256(entropy)
+
+	wantChecksum := newBitReader(checksum[:]).read(checksumBits)
+	gotChecksum := newBitReader(bits.bytes()[entropyBits/8:]).read(checksumBits)
+	if wantChecksum != gotChecksum {
+		return ErrInvalidChecksum
+	}
+
+	return nil
+}
+
+// normalize converts s to its NFKD form, which BIP-39 applies to both the
+// mnemonic and the passphrase before they are fed to the KDF.
+func normalize(s string) (string, error) {
+	return norm.NFKD.String(s), nil
+}
+
+// wordIndex returns the position of word in the English wordlist.
+func wordIndex(word string) (int, bool) {
+	for i, candidate := range englishWordlist {
+		if candidate == word {
+			return i, true
+		}
+	}
+
+	return 0, false
+}
@@ -0,0 +1,81 @@
+package mnemonic
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"testing"
+)
+
+func Test_Mnemonic(t *testing.T) {
+	f := func(t *testing.T, entropyHex, wantedMnemonic, passphrase, wantedSeedHex string) {
+		entropy, err := hex.DecodeString(entropyHex)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		mnemonic, err := NewMnemonic(entropy)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if mnemonic != wantedMnemonic {
+			t.Fatalf(
+				"Invalid mnemonic:\nWant: %s\nGot:  %s\n",
+				wantedMnemonic,
+				mnemonic,
+			)
+		}
+
+		if err := ValidateMnemonic(mnemonic); err != nil {
+			t.Fatalf("expected mnemonic to be valid: %v", err)
+		}
+
+		seed, err := MnemonicToSeed(mnemonic, passphrase)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if hex.EncodeToString(seed) != wantedSeedHex {
+			t.Fatalf(
+				"Invalid seed:\nWant: %s\nGot:  %s\n",
+				wantedSeedHex,
+				hex.EncodeToString(seed),
+			)
+		}
+	}
+
+	var tests []struct {
+		Entropy    string `json:"entropy"`
+		Mnemonic   string `json:"mnemonic"`
+		Passphrase string `json:"passphrase"`
+		Seed       string `json:"seed"`
+	}
+	file, err := os.Open("tests/mnemonic.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	if err := json.NewDecoder(file).Decode(&tests); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, test := range tests {
+		f(t, test.Entropy, test.Mnemonic, test.Passphrase, test.Seed)
+	}
+}
+
+func Test_ValidateMnemonic_invalidChecksum(t *testing.T) {
+	mnemonic := "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon"
+	if err := ValidateMnemonic(mnemonic); err == nil {
+		t.Fatal("expected invalid checksum error")
+	}
+}
+
+func Test_ValidateMnemonic_unknownWord(t *testing.T) {
+	mnemonic := "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon zzzzz"
+	if err := ValidateMnemonic(mnemonic); err == nil {
+		t.Fatal("expected unknown word error")
+	}
+}
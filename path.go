@@ -17,17 +17,113 @@ const (
 
 // WithdrawalKeyPath return a key path in form of m/12381/3600/i/0
 func WithdrawalKeyPath(account uint32) string {
-	return fmt.Sprintf("m/%d/%d/%d/0", Purpose, CoinType, account)
+	return KeyPath(Purpose, CoinType, account, 0)
 }
 
 // SigningKeyPath returns a key path of m/12381/3600/i/0/0
 func SigningKeyPath(account uint32) string {
-	return fmt.Sprintf("m/%d/%d/%d/0/0", Purpose, CoinType, account)
+	return KeyPath(Purpose, CoinType, account, 0, 0)
+}
+
+// KeyPath builds a generic derivation path m/purpose/coinType/subpath... so
+// that consumers deriving keys for chains other than Ethereum can reuse
+// EIP-2334-style derivation without hardcoding its purpose (12381) and coin
+// type (3600) constants.
+func KeyPath(purpose, coinType uint32, subpath ...uint32) string {
+	nodes := make([]string, 0, len(subpath)+3)
+	nodes = append(nodes, "m", strconv.FormatUint(uint64(purpose), 10), strconv.FormatUint(uint64(coinType), 10))
+	for _, index := range subpath {
+		nodes = append(nodes, strconv.FormatUint(uint64(index), 10))
+	}
+
+	return strings.Join(nodes, "/")
 }
 
 // ErrInvalidPath
 var ErrInvalidPath = errors.New("invalid path")
 
+// ErrNotWithdrawalPath is returned by SigningKeyPathFor when given a path
+// that isn't a withdrawal key path.
+var ErrNotWithdrawalPath = errors.New("not a withdrawal key path")
+
+// ParsedPath exposes the individual EIP-2334 path components: purpose,
+// coin type, account, use (the withdrawal key index, always 0) and,
+// when the path identifies a signing key, its index (always 0).
+type ParsedPath struct {
+	Purpose  uint32
+	CoinType uint32
+	Account  uint32
+	Use      uint32
+	Signing  bool
+	Index    uint32
+}
+
+// ValidatePath enforces the EIP-2334 m/12381/3600/i/... structure: purpose
+// must be 12381, coin type 3600, and the path must identify either a
+// withdrawal key (m/12381/3600/i/0) or a signing key (m/12381/3600/i/0/0).
+func ValidatePath(path string) error {
+	_, err := ParsePath(path)
+	return err
+}
+
+// ParsePath validates path against the EIP-2334 structure and returns its
+// components.
+func ParsePath(path string) (*ParsedPath, error) {
+	indices, err := parsePath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(indices) != 4 && len(indices) != 5 {
+		return nil, fmt.Errorf("%w: expected 4 or 5 path components, got %d", ErrInvalidPath, len(indices))
+	}
+
+	if indices[0] != Purpose {
+		return nil, fmt.Errorf("%w: purpose must be %d, got %d", ErrInvalidPath, Purpose, indices[0])
+	}
+
+	if indices[1] != CoinType {
+		return nil, fmt.Errorf("%w: coin type must be %d, got %d", ErrInvalidPath, CoinType, indices[1])
+	}
+
+	if indices[3] != 0 {
+		return nil, fmt.Errorf("%w: use must be 0, got %d", ErrInvalidPath, indices[3])
+	}
+
+	parsed := &ParsedPath{
+		Purpose:  indices[0],
+		CoinType: indices[1],
+		Account:  indices[2],
+		Use:      indices[3],
+	}
+
+	if len(indices) == 5 {
+		if indices[4] != 0 {
+			return nil, fmt.Errorf("%w: signing key index must be 0, got %d", ErrInvalidPath, indices[4])
+		}
+
+		parsed.Signing = true
+		parsed.Index = indices[4]
+	}
+
+	return parsed, nil
+}
+
+// SigningKeyPathFor derives the signing key path (m/12381/3600/i/0/0) for
+// the given withdrawal key path (m/12381/3600/i/0).
+func SigningKeyPathFor(withdrawalPath string) (string, error) {
+	parsed, err := ParsePath(withdrawalPath)
+	if err != nil {
+		return "", err
+	}
+
+	if parsed.Signing {
+		return "", fmt.Errorf("%w: %s is already a signing key path", ErrNotWithdrawalPath, withdrawalPath)
+	}
+
+	return KeyPath(parsed.Purpose, parsed.CoinType, parsed.Account, parsed.Use, 0), nil
+}
+
 // parsePath returns the indices of child keys
 func parsePath(path string) ([]uint32, error) {
 	path = strings.ReplaceAll(path, " ", "")
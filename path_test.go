@@ -0,0 +1,51 @@
+package gobls12381
+
+import "testing"
+
+func Test_ValidatePath(t *testing.T) {
+	valid := []string{
+		"m/12381/3600/0/0",
+		"m/12381/3600/0/0/0",
+		"m/12381/3600/7/0/0",
+	}
+	for _, path := range valid {
+		if err := ValidatePath(path); err != nil {
+			t.Fatalf("expected %q to be valid, got: %v", path, err)
+		}
+	}
+
+	invalid := []string{
+		"m/12381/3600/0",
+		"m/12381/3600/0/1",
+		"m/44/3600/0/0",
+		"m/12381/60/0/0",
+		"m/12381/3600/0/0/1",
+		"not-a-path",
+	}
+	for _, path := range invalid {
+		if err := ValidatePath(path); err == nil {
+			t.Fatalf("expected %q to be invalid", path)
+		}
+	}
+}
+
+func Test_SigningKeyPathFor(t *testing.T) {
+	signingPath, err := SigningKeyPathFor(WithdrawalKeyPath(7))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if wanted := SigningKeyPath(7); signingPath != wanted {
+		t.Fatalf("Invalid signing path:\nWant: %s\nGot:  %s\n", wanted, signingPath)
+	}
+
+	if _, err := SigningKeyPathFor(SigningKeyPath(7)); err == nil {
+		t.Fatal("expected error when given a signing key path")
+	}
+}
+
+func Test_KeyPath(t *testing.T) {
+	if got, want := KeyPath(Purpose, CoinType, 7, 0), WithdrawalKeyPath(7); got != want {
+		t.Fatalf("Invalid path:\nWant: %s\nGot:  %s\n", want, got)
+	}
+}